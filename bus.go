@@ -12,6 +12,7 @@ func NewEventBus() (*EventBus) {
     bus := &EventBus{
         subscription: make(Subscription),
         dispatcher:   make(chan interface{}),
+        topics:       newTopicSubscription(),
     }
 
     go bus.pollerBus()
@@ -24,6 +25,7 @@ func NewEventBusBuffered(chanSize int) (*EventBus) {
     bus := &EventBus{
         subscription: make(Subscription),
         dispatcher:   make(chan interface{}, chanSize),
+        topics:       newTopicSubscription(),
     }
 
     go bus.pollerBus()
@@ -43,6 +45,16 @@ func NewEventBusBuffered(chanSize int) (*EventBus) {
 func (bus *EventBus) Destruct() {
     bus.waitGroup.Wait()
     close(bus.dispatcher)
+
+    if bus.jobs != nil {
+        close(bus.jobs)
+    }
+
+    bus.serialMutex.Lock()
+    for _, queue := range bus.serialQueues {
+        close(queue)
+    }
+    bus.serialMutex.Unlock()
 }
 
 // Subscribe a listener to certain events.
@@ -68,37 +80,98 @@ func (bus *EventBus) UnSubscribe(listeners ...interface{}) (*EventBus) {
 // The event bus notifies the poller goroutine, which will retrieve the correct subscribed
 // listeners and calls them with a copy of the event published.
 func (bus *EventBus) Publish(event interface{}) (*EventBus) {
-    bus.waitGroup.Add(1)    // Waiting for alerting
-    bus.dispatcher <- event // Publishing event into the dispatcher channel
+    bus.waitGroup.Add(1) // Waiting for alerting
+
+    if bus.hasSyncListeners.Load() {
+        // At least one SubscribeSync listener exists somewhere on this bus:
+        // block until this event's sync pass has completed.
+        done := make(chan struct{})
+        bus.dispatcher <- publishEnvelope{event: event, done: done}
+        <-done
+    } else {
+        bus.dispatcher <- event // Publishing event into the dispatcher channel
+    }
     return bus
 }
 
-// Retrieves all the listener subscribed to the event type
-// and calls them asynchronously (decorated listeners for waitgroup signal)
-func (bus *EventBus) alertListeners(event interface{}) {
-    listeners, err := bus.subscription.GetListeners(reflect.TypeOf(event))
+// Retrieves all the listeners subscribed to the event type and alerts them.
+// SubscribeSync decorated listeners are called serially, right here, before
+// done is closed (so Publish can block on their completion); the plain
+// Subscribe listeners and the remaining (async) decorated listeners are
+// then handed to dispatchListener/dispatchDecorated as before.
+// done may be nil, meaning no caller is waiting on the sync pass.
+func (bus *EventBus) alertListeners(event interface{}, done chan struct{}) {
+    t := reflect.TypeOf(event)
+    decorated := bus.decoratedFor(t)
+
+    for _, entry := range decorated {
+        if !entry.sync || (entry.predicate != nil && !entry.predicate(event)) {
+            continue
+        }
+
+        bus.waitGroup.Add(1)
+        bus.callDecorated(entry, t, event) // Called inline: Publish waits on this
+    }
+
+    if done != nil {
+        close(done)
+    }
+
+    listeners, err := bus.subscription.GetListeners(t)
     if err == nil {
         for _, listener := range listeners.Values() {
-            bus.waitGroup.Add(1)    // Waiting for listener callback
-            go bus.executingWithWaiting(listener, event)    // Decorator :-)
+            bus.waitGroup.Add(1) // Waiting for listener callback
+            bus.dispatchListener(listener, event)
         }
     } else {
         log.Print(err)
     }
 
+    for _, entry := range decorated {
+        if entry.sync {
+            continue    // Already handled in the sync pass above
+        }
+        if entry.predicate != nil && !entry.predicate(event) {
+            continue    // Predicate rejected this event, skip dispatch entirely
+        }
+
+        bus.waitGroup.Add(1)
+        bus.dispatchDecorated(entry, t, event)
+    }
+
     // Alerting finished
     bus.waitGroup.Done()
 }
 
-// Decorator for listener execution on the event.
-// Calls the listener and signals completion on the EventBus waitgroup.
-func (bus *EventBus) executingWithWaiting(listener interface{}, event interface{}) {
-    funct, evt := reflect.ValueOf(listener), reflect.ValueOf(event)
+// callDecorated invokes entry's listener inline (not on its own goroutine).
+// For a once-entry, it first claims entry by removing it from bus.decorated;
+// if another goroutine's alertListeners call already claimed it first, this
+// call backs off without invoking the listener a second time.
+func (bus *EventBus) callDecorated(entry *decoratedListener, t reflect.Type, event interface{}) {
+    if entry.once && !bus.removeDecorated(t, entry) {
+        bus.waitGroup.Done()
+        return
+    }
+
+    funct, evt := reflect.ValueOf(entry.listener), reflect.ValueOf(event)
     funct.Call([]reflect.Value{evt})
 
     bus.waitGroup.Done()
 }
 
+// dispatchDecorated schedules entry's listener the same way dispatchListener
+// does for plain Subscribe listeners. For a once-entry, it claims entry
+// before scheduling anything, for the same reason callDecorated does: so a
+// losing concurrent Publish call never dispatches the job at all.
+func (bus *EventBus) dispatchDecorated(entry *decoratedListener, t reflect.Type, event interface{}) {
+    if entry.once && !bus.removeDecorated(t, entry) {
+        bus.waitGroup.Done()
+        return
+    }
+
+    bus.dispatchJob(job{listener: entry.listener, event: event})
+}
+
 // Bus poller loop, executed asynchronously on bus creation.
 // Listens for new event incoming and dispatches them to the listeners-alerting goroutine.
 func (bus *EventBus) pollerBus() {
@@ -109,8 +182,18 @@ func (bus *EventBus) pollerBus() {
             if !ok {
                 return
             }
-            // New event received, alerting listeners asynchronously
-            go bus.alertListeners(v)
+
+            switch payload := v.(type) {
+            case replyEnvelope:
+                // Request/reply event, alerting the registered replier asynchronously
+                go bus.alertReplier(payload)
+            case publishEnvelope:
+                // Event with sync listeners to wait on, alerting listeners asynchronously
+                go bus.alertListeners(payload.event, payload.done)
+            default:
+                // New event received, alerting listeners asynchronously
+                go bus.alertListeners(payload, nil)
+            }
 
         default:
             // Yields the processor and let other goroutines to execute
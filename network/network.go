@@ -0,0 +1,145 @@
+// Package network distributes a gobus.EventBus across processes over TCP,
+// serializing events with encoding/gob.
+package network
+
+import (
+    "encoding/gob"
+    "log"
+    "net"
+    "reflect"
+    "sync"
+
+    "github.com/Eipeks/gobus"
+)
+
+// envelope wraps an event for gob transport, since gob cannot decode into a
+// bare interface{} without a concrete type carried alongside it.
+type envelope struct {
+    Event interface{}
+}
+
+// syncEncoder serializes access to a *gob.Encoder, since a single Client
+// connection's encoder is shared by one forwarding listener per eventType
+// and gob.Encoder is not safe for concurrent use.
+type syncEncoder struct {
+    mutex   sync.Mutex
+    encoder *gob.Encoder
+}
+
+func (se *syncEncoder) Encode(env envelope) (error) {
+    se.mutex.Lock()
+    defer se.mutex.Unlock()
+    return se.encoder.Encode(env)
+}
+
+// NetworkEventBus wraps an EventBus so that events published locally can be
+// forwarded to remote peers, and events received from remote peers are
+// re-published into the local bus.
+type NetworkEventBus struct {
+    *gobus.EventBus
+
+    listener   net.Listener
+    connsMutex sync.Mutex
+    conns      []net.Conn
+}
+
+// addConn appends conn to netBus.conns under connsMutex.
+func (netBus *NetworkEventBus) addConn(conn net.Conn) {
+    netBus.connsMutex.Lock()
+    defer netBus.connsMutex.Unlock()
+    netBus.conns = append(netBus.conns, conn)
+}
+
+// NewNetworkEventBus wraps bus for network distribution.
+func NewNetworkEventBus(bus *gobus.EventBus) (*NetworkEventBus) {
+    return &NetworkEventBus{EventBus: bus}
+}
+
+// Server listens on addr and accepts subscriber connections. Events decoded
+// off a connection are re-published into the local bus, so local listeners
+// fire transparently for remotely published events. Each sample in
+// eventTypes is registered with gob.Register so incoming envelopes carrying
+// that concrete type can be decoded into env.Event.
+func (netBus *NetworkEventBus) Server(addr string, eventTypes ...interface{}) (error) {
+    for _, sample := range eventTypes {
+        gob.Register(sample)
+    }
+
+    ln, err := net.Listen("tcp", addr)
+    if err != nil {
+        return err
+    }
+    netBus.listener = ln
+
+    go func() {
+        for {
+            conn, err := ln.Accept()
+            if err != nil {
+                return
+            }
+            netBus.addConn(conn)
+            go netBus.serveConn(conn)
+        }
+    }()
+    return nil
+}
+
+// serveConn decodes events off conn until it is closed or decoding fails.
+func (netBus *NetworkEventBus) serveConn(conn net.Conn) {
+    decoder := gob.NewDecoder(conn)
+    for {
+        var env envelope
+        if err := decoder.Decode(&env); err != nil {
+            return
+        }
+        netBus.Publish(env.Event)
+    }
+}
+
+// Client dials addr and forwards every locally published event whose type
+// matches one of eventTypes to the remote peer. Each sample in eventTypes
+// is registered with gob.Register so the remote side can decode it.
+func (netBus *NetworkEventBus) Client(addr string, eventTypes ...interface{}) (error) {
+    conn, err := net.Dial("tcp", addr)
+    if err != nil {
+        return err
+    }
+    netBus.addConn(conn)
+
+    encoder := &syncEncoder{encoder: gob.NewEncoder(conn)}
+    for _, sample := range eventTypes {
+        gob.Register(sample)
+        netBus.subscribeForward(sample, encoder)
+    }
+    return nil
+}
+
+// subscribeForward builds a listener matching sample's type via reflection
+// and subscribes it to encode and forward events of that type to encoder.
+func (netBus *NetworkEventBus) subscribeForward(sample interface{}, encoder *syncEncoder) {
+    t := reflect.TypeOf(sample)
+    forward := reflect.MakeFunc(reflect.FuncOf([]reflect.Type{t}, nil, false),
+        func(args []reflect.Value) ([]reflect.Value) {
+            if err := encoder.Encode(envelope{Event: args[0].Interface()}); err != nil {
+                log.Print(err)
+            }
+            return nil
+        })
+    netBus.Subscribe(forward.Interface())
+}
+
+// Destruct closes every connection and the listener, then tears down the
+// underlying EventBus.
+func (netBus *NetworkEventBus) Destruct() {
+    netBus.connsMutex.Lock()
+    conns := netBus.conns
+    netBus.connsMutex.Unlock()
+
+    for _, conn := range conns {
+        conn.Close()
+    }
+    if netBus.listener != nil {
+        netBus.listener.Close()
+    }
+    netBus.EventBus.Destruct()
+}
@@ -0,0 +1,91 @@
+package gobus
+
+import "reflect"
+
+// NewEventBusPooled creates a new EventBus with a dispatcher buffered
+// channel whose listener invocations are routed through a fixed-size pool
+// of workers goroutines, instead of one goroutine per listener per event.
+func NewEventBusPooled(chanSize int, workers int) (*EventBus) {
+    bus := &EventBus{
+        subscription: make(Subscription),
+        dispatcher:   make(chan interface{}, chanSize),
+        topics:       newTopicSubscription(),
+        jobs:         make(chan job, chanSize),
+    }
+
+    for i := 0; i < workers; i++ {
+        go bus.worker(bus.jobs)
+    }
+
+    go bus.pollerBus()
+    return bus
+}
+
+// NewEventBusPooledSerial creates a new EventBus with a dispatcher buffered
+// channel whose listener invocations are run FIFO-serially per event type:
+// every event type is keyed to its own single-worker queue, so handlers for
+// the same event type never race each other.
+func NewEventBusPooledSerial(chanSize int) (*EventBus) {
+    bus := &EventBus{
+        subscription: make(Subscription),
+        dispatcher:   make(chan interface{}, chanSize),
+        topics:       newTopicSubscription(),
+        serialQueues: make(map[reflect.Type]chan job),
+    }
+
+    go bus.pollerBus()
+    return bus
+}
+
+// worker drains jobs, executing each listener invocation in turn.
+func (bus *EventBus) worker(jobs chan job) {
+    for j := range jobs {
+        bus.executeJob(j)
+    }
+}
+
+// executeJob calls j.listener with j.event and signals completion on the
+// waitgroup. This is the single execution path shared by the
+// goroutine-per-invocation, pooled and serial dispatch modes.
+func (bus *EventBus) executeJob(j job) {
+    funct, evt := reflect.ValueOf(j.listener), reflect.ValueOf(j.event)
+    funct.Call([]reflect.Value{evt})
+
+    bus.waitGroup.Done()
+}
+
+// dispatchListener schedules a single listener invocation according to the
+// bus' dispatch mode: FIFO-serial per event type, through the worker pool,
+// or one goroutine per invocation (the default).
+func (bus *EventBus) dispatchListener(listener interface{}, event interface{}) {
+    bus.dispatchJob(job{listener: listener, event: event})
+}
+
+// dispatchJob schedules a single listener invocation according to the
+// bus' dispatch mode, same as dispatchListener; dispatchDecorated calls
+// this directly once it has already decided the invocation should happen.
+func (bus *EventBus) dispatchJob(j job) {
+    switch {
+    case bus.serialQueues != nil:
+        bus.serialQueueFor(reflect.TypeOf(j.event)) <- j
+    case bus.jobs != nil:
+        bus.jobs <- j
+    default:
+        go bus.executeJob(j)
+    }
+}
+
+// serialQueueFor returns the single-worker queue for t, creating it (and
+// its worker goroutine) on first use.
+func (bus *EventBus) serialQueueFor(t reflect.Type) (chan job) {
+    bus.serialMutex.Lock()
+    defer bus.serialMutex.Unlock()
+
+    queue, ok := bus.serialQueues[t]
+    if !ok {
+        queue = make(chan job, 64)
+        bus.serialQueues[t] = queue
+        go bus.worker(queue)
+    }
+    return queue
+}
@@ -0,0 +1,69 @@
+package gobus
+
+import (
+    "errors"
+    "log"
+    "reflect"
+    "time"
+)
+
+// ErrReplyTimeout is returned by PublishWithReply when no replier responds
+// before the given timeout elapses.
+var ErrReplyTimeout = errors.New("gobus: no replier responded before timeout")
+
+// SubscribeReplier registers fn as the replier for its single input event
+// type. fn must have the signature func(EventT) ReplyT; only the first
+// replier registered for a given event type is ever invoked.
+func (bus *EventBus) SubscribeReplier(fn interface{}) (*EventBus) {
+    t := reflect.TypeOf(fn)
+    if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 1 {
+        log.Printf("gobus: SubscribeReplier requires a func(EventT) ReplyT, got %T", fn)
+        return bus
+    }
+
+    bus.repliersMutex.Lock()
+    defer bus.repliersMutex.Unlock()
+
+    if bus.repliers == nil {
+        bus.repliers = make(map[reflect.Type]interface{})
+    }
+    if _, exists := bus.repliers[t.In(0)]; !exists {
+        bus.repliers[t.In(0)] = fn // Only the first replier registered for a type is kept
+    }
+    return bus
+}
+
+// PublishWithReply publishes event and blocks until the registered replier
+// for its type returns a value, or until timeout elapses, in which case it
+// returns ErrReplyTimeout.
+func (bus *EventBus) PublishWithReply(event interface{}, timeout time.Duration) (interface{}, error) {
+    reply := make(chan interface{}, 1)
+
+    bus.waitGroup.Add(1)    // Waiting for alerting
+    bus.dispatcher <- replyEnvelope{event: event, reply: reply}
+
+    select {
+    case result := <-reply:
+        return result, nil
+    case <-time.After(timeout):
+        return nil, ErrReplyTimeout
+    }
+}
+
+// alertReplier invokes the replier registered for env.event's type and
+// delivers its return value back through env.reply.
+func (bus *EventBus) alertReplier(env replyEnvelope) {
+    defer bus.waitGroup.Done()
+
+    bus.repliersMutex.Lock()
+    replier, ok := bus.repliers[reflect.TypeOf(env.event)]
+    bus.repliersMutex.Unlock()
+    if !ok {
+        log.Printf("gobus: no replier registered for %T", env.event)
+        return
+    }
+
+    funct, evt := reflect.ValueOf(replier), reflect.ValueOf(env.event)
+    out := funct.Call([]reflect.Value{evt})
+    env.reply <- out[0].Interface()
+}
@@ -0,0 +1,62 @@
+package gobus
+
+import "reflect"
+
+// SubscribeOnce subscribes listener so that it automatically unsubscribes
+// itself right after the first matching event it receives.
+func (bus *EventBus) SubscribeOnce(listener interface{}) (*EventBus) {
+    bus.addDecorated(listener, &decoratedListener{listener: listener, once: true})
+    return bus
+}
+
+// SubscribeWhere subscribes listener but only invokes it for events that
+// satisfy predicate; events that don't match are silently skipped.
+func (bus *EventBus) SubscribeWhere(listener interface{}, predicate func(event interface{}) (bool)) (*EventBus) {
+    bus.addDecorated(listener, &decoratedListener{listener: listener, predicate: predicate})
+    return bus
+}
+
+// addDecorated registers entry for listener's single input type. Entries
+// are kept in a per-type slice rather than a map keyed by the listener's
+// func pointer, since distinct closures created from the same literal (the
+// natural way to register several Subscribe* listeners in a loop) report
+// the same reflect.Value.Pointer() despite being different instances.
+func (bus *EventBus) addDecorated(listener interface{}, entry *decoratedListener) {
+    t := reflect.TypeOf(listener).In(0)
+
+    bus.decoratedMutex.Lock()
+    defer bus.decoratedMutex.Unlock()
+
+    if bus.decorated == nil {
+        bus.decorated = make(map[reflect.Type][]*decoratedListener)
+    }
+    bus.decorated[t] = append(bus.decorated[t], entry)
+}
+
+// decoratedFor returns a snapshot of the decorated listeners registered for
+// t, safe to range over without holding decoratedMutex.
+func (bus *EventBus) decoratedFor(t reflect.Type) ([]*decoratedListener) {
+    bus.decoratedMutex.Lock()
+    defer bus.decoratedMutex.Unlock()
+
+    return append([]*decoratedListener(nil), bus.decorated[t]...)
+}
+
+// removeDecorated forgets entry and reports whether it was still present.
+// For a once-entry this doubles as the claim step: alertListeners calls it
+// before invoking the listener, so when two Publish calls race for the
+// same once-entry, only the one whose removeDecorated returns true may
+// call it, and the other sees false and skips the invocation entirely.
+func (bus *EventBus) removeDecorated(t reflect.Type, entry *decoratedListener) (bool) {
+    bus.decoratedMutex.Lock()
+    defer bus.decoratedMutex.Unlock()
+
+    entries := bus.decorated[t]
+    for i, e := range entries {
+        if e == entry {
+            bus.decorated[t] = append(entries[:i], entries[i+1:]...)
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,58 @@
+package gobus
+
+import (
+    "sync"
+    "sync/atomic"
+    "testing"
+)
+
+// TestSubscribeOnceFiresOnceSequential guards against a once-entry being
+// claimed only after its listener returns: ten sequential Publish calls
+// must still reach the listener exactly once, not once per call.
+func TestSubscribeOnceFiresOnceSequential(t *testing.T) {
+    bus := NewEventBus()
+    defer bus.Destruct()
+
+    var calls int32
+    bus.SubscribeOnce(func(n int) {
+        atomic.AddInt32(&calls, 1)
+    })
+
+    for i := 0; i < 10; i++ {
+        bus.Publish(i)
+    }
+    bus.waitGroup.Wait()
+
+    if got := atomic.LoadInt32(&calls); got != 1 {
+        t.Fatalf("listener fired %d times, want 1", got)
+    }
+}
+
+// TestSubscribeOnceFiresOnceConcurrent is the same guarantee under
+// concurrent Publish calls, where the race between two alertListeners
+// calls both seeing the once-entry before either removes it is easiest
+// to reproduce.
+func TestSubscribeOnceFiresOnceConcurrent(t *testing.T) {
+    bus := NewEventBus()
+    defer bus.Destruct()
+
+    var calls int32
+    bus.SubscribeOnce(func(n int) {
+        atomic.AddInt32(&calls, 1)
+    })
+
+    var wg sync.WaitGroup
+    for i := 0; i < 20; i++ {
+        wg.Add(1)
+        go func(n int) {
+            defer wg.Done()
+            bus.Publish(n)
+        }(i)
+    }
+    wg.Wait()
+    bus.waitGroup.Wait()
+
+    if got := atomic.LoadInt32(&calls); got != 1 {
+        t.Fatalf("listener fired %d times, want 1", got)
+    }
+}
@@ -0,0 +1,12 @@
+package gobus
+
+// SubscribeSync subscribes listener so that it is invoked serially on the
+// poller's alerting goroutine, with no goroutine of its own, and guarantees
+// that it has completed by the time Publish returns for a matching event.
+// It can be freely mixed with async Subscribe listeners on the same bus,
+// for example to run ordered validation before fire-and-forget consumers.
+func (bus *EventBus) SubscribeSync(listener interface{}) (*EventBus) {
+    bus.addDecorated(listener, &decoratedListener{listener: listener, sync: true})
+    bus.hasSyncListeners.Store(true)
+    return bus
+}
@@ -0,0 +1,34 @@
+package gobus
+
+import (
+    "sync"
+    "testing"
+)
+
+// TestHasSyncListenersConcurrentAccess exercises SubscribeSync and Publish
+// running concurrently -- the bus' normal operating mode, and the scenario
+// in which bus.hasSyncListeners used to race between SubscribeSync's write
+// and Publish's read. It makes no assertion on call count, since whether
+// the sync listener was registered before or after the Publish call is
+// inherently racy; its purpose is to trip `go test -race` if the field is
+// ever touched outside of atomic.Bool's Load/Store again.
+func TestHasSyncListenersConcurrentAccess(t *testing.T) {
+    bus := NewEventBus()
+    defer bus.Destruct()
+
+    var wg sync.WaitGroup
+    wg.Add(2)
+
+    go func() {
+        defer wg.Done()
+        bus.SubscribeSync(func(n int) {})
+    }()
+
+    go func() {
+        defer wg.Done()
+        bus.Publish(1)
+    }()
+
+    wg.Wait()
+    bus.waitGroup.Wait()
+}
@@ -0,0 +1,143 @@
+package gobus
+
+import (
+    "reflect"
+    "strings"
+)
+
+const (
+    topicWildcardOne = "*" // matches exactly one segment
+    topicWildcardAny = "#" // matches zero-or-more trailing segments
+)
+
+// newTopicSubscription allocates an empty trie node.
+func newTopicSubscription() (*TopicSubscription) {
+    return &TopicSubscription{children: make(map[string]*TopicSubscription)}
+}
+
+// SubscribeTopic subscribes fn to topic, a dot-segmented string such as
+// "orders.created" or "orders.*.created". Unlike Subscribe, dispatch is
+// addressed by topic rather than by the argument's Go type, and fn is
+// invoked via reflection with whatever args PublishTopic was given.
+// It returns an opaque handle identifying this one subscription, to be
+// passed to UnsubscribeTopic; the handle (not fn) disambiguates distinct
+// subscriptions that happen to share a function literal, for example
+// several registered from the same closure inside a loop.
+func (bus *EventBus) SubscribeTopic(topic string, fn interface{}) (*topicListener) {
+    bus.topicsMutex.Lock()
+    defer bus.topicsMutex.Unlock()
+
+    node := bus.topics
+    for _, segment := range strings.Split(topic, ".") {
+        child, ok := node.children[segment]
+        if !ok {
+            child = newTopicSubscription()
+            node.children[segment] = child
+        }
+        node = child
+    }
+
+    entry := &topicListener{node: node, fn: fn}
+    node.listeners = append(node.listeners, entry)
+    return entry
+}
+
+// UnsubscribeTopic removes the subscription identified by handle, as
+// returned by the corresponding SubscribeTopic call.
+func (bus *EventBus) UnsubscribeTopic(handle *topicListener) (*EventBus) {
+    if handle == nil || handle.node == nil {
+        return bus
+    }
+
+    bus.topicsMutex.Lock()
+    defer bus.topicsMutex.Unlock()
+
+    node := handle.node
+    for i, listener := range node.listeners {
+        if listener == handle {
+            node.listeners = append(node.listeners[:i], node.listeners[i+1:]...)
+            break
+        }
+    }
+    return bus
+}
+
+// HasCallback reports whether any listener is registered for the exact
+// topic (wildcard segments are matched literally, not expanded).
+func (bus *EventBus) HasCallback(topic string) (bool) {
+    bus.topicsMutex.Lock()
+    defer bus.topicsMutex.Unlock()
+
+    node := bus.topics
+    for _, segment := range strings.Split(topic, ".") {
+        child, ok := node.children[segment]
+        if !ok {
+            return false
+        }
+        node = child
+    }
+    return len(node.listeners) > 0
+}
+
+// PublishTopic publishes args to every listener subscribed to topic or to a
+// wildcard pattern matching it: "*" matches exactly one segment and "#"
+// matches the remainder of the topic, including zero segments.
+func (bus *EventBus) PublishTopic(topic string, args ...interface{}) (*EventBus) {
+    bus.topicsMutex.Lock()
+    defer bus.topicsMutex.Unlock()
+
+    bus.matchTopic(bus.topics, strings.Split(topic, "."), args)
+    return bus
+}
+
+// matchTopic walks the trie alongside the remaining topic segments,
+// fanning out at "*" and "#" children in addition to the exact match.
+// A "#" child matches zero-or-more trailing segments in one shot: as soon
+// as it's reached, its listeners are invoked directly and matching along
+// that branch stops there. It must not also recurse into matchTopic, or a
+// topic with segments left over past the "#" would never reach the
+// len(segments) == 0 base case to fire, while a topic with exactly one
+// segment left would fire twice (once here, once from that base case).
+// Callers must hold topicsMutex for the whole walk; matchTopic itself does
+// not lock, since it recurses and the mutex isn't reentrant.
+func (bus *EventBus) matchTopic(node *TopicSubscription, segments []string, args []interface{}) {
+    if len(segments) == 0 {
+        bus.invokeTopicListeners(node.listeners, args)
+        if any, ok := node.children[topicWildcardAny]; ok {
+            bus.invokeTopicListeners(any.listeners, args)
+        }
+        return
+    }
+
+    if exact, ok := node.children[segments[0]]; ok {
+        bus.matchTopic(exact, segments[1:], args)
+    }
+    if star, ok := node.children[topicWildcardOne]; ok {
+        bus.matchTopic(star, segments[1:], args)
+    }
+    if any, ok := node.children[topicWildcardAny]; ok {
+        bus.invokeTopicListeners(any.listeners, args)
+    }
+}
+
+// invokeTopicListeners alerts the given listeners asynchronously, mirroring
+// alertListeners' waitGroup accounting for the type-dispatched path.
+func (bus *EventBus) invokeTopicListeners(listeners []*topicListener, args []interface{}) {
+    for _, listener := range listeners {
+        bus.waitGroup.Add(1)
+        go bus.executingTopicListener(listener.fn, args)
+    }
+}
+
+// executingTopicListener calls listener with args via reflection and
+// signals completion on the EventBus waitgroup.
+func (bus *EventBus) executingTopicListener(listener interface{}, args []interface{}) {
+    funct := reflect.ValueOf(listener)
+    in := make([]reflect.Value, len(args))
+    for i, arg := range args {
+        in[i] = reflect.ValueOf(arg)
+    }
+    funct.Call(in)
+
+    bus.waitGroup.Done()
+}
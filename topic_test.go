@@ -0,0 +1,57 @@
+package gobus
+
+import (
+    "sync"
+    "sync/atomic"
+    "testing"
+)
+
+// TestPublishTopicFiresOnceForHashWildcard guards against matchTopic's "#"
+// handler invoking a listener more than once per PublishTopic call: a
+// listener registered under "orders.#" must fire exactly once for a topic
+// with segments left over past the "#", not once per remaining segment.
+func TestPublishTopicFiresOnceForHashWildcard(t *testing.T) {
+    bus := NewEventBus()
+    defer bus.Destruct()
+
+    var calls int32
+    bus.SubscribeTopic("orders.#", func() {
+        atomic.AddInt32(&calls, 1)
+    })
+
+    bus.PublishTopic("orders.a.b")
+    bus.waitGroup.Wait()
+
+    if got := atomic.LoadInt32(&calls); got != 1 {
+        t.Fatalf("listener fired %d times, want 1", got)
+    }
+}
+
+// TestTopicTrieConcurrentAccess exercises SubscribeTopic, PublishTopic and
+// UnsubscribeTopic from many goroutines at once. It makes no behavioural
+// assertion; its purpose is to trip `go test -race` if the trie is ever
+// read or written without topicsMutex held.
+func TestTopicTrieConcurrentAccess(t *testing.T) {
+    bus := NewEventBus()
+    defer bus.Destruct()
+
+    var wg sync.WaitGroup
+    for i := 0; i < 50; i++ {
+        wg.Add(3)
+        go func() {
+            defer wg.Done()
+            handle := bus.SubscribeTopic("orders.created", func() {})
+            bus.UnsubscribeTopic(handle)
+        }()
+        go func() {
+            defer wg.Done()
+            bus.PublishTopic("orders.created")
+        }()
+        go func() {
+            defer wg.Done()
+            bus.HasCallback("orders.created")
+        }()
+    }
+    wg.Wait()
+    bus.waitGroup.Wait()
+}
@@ -1,6 +1,10 @@
 package gobus
 
-import "sync"
+import (
+    "reflect"
+    "sync"
+    "sync/atomic"
+)
 
 type (
     // Map that holds all listener references, indexed through input argument name.
@@ -15,12 +19,87 @@ type (
     //
     Subscription map[string]IListenerSet
 
+    // TopicSubscription is a trie node keyed by dot-segmented topic strings.
+    // Each node optionally holds listeners registered for the exact path
+    // leading to it, plus child nodes for its sub-segments (including the
+    // wildcard segments "*" and "#").
+    // Example of a topic trie:
+    //     topics
+    //     |--> orders
+    //          |--> *
+    //          |    |--> created  -->  onOrderCreated()
+    //          |--> #  -->  onAnyOrderEvent()
+    //
+    TopicSubscription struct {
+        children  map[string]*TopicSubscription
+        listeners []*topicListener
+    }
+
+    // topicListener pairs a subscribed function with the trie node it was
+    // registered on, doubling as the opaque handle SubscribeTopic returns.
+    // Unlike reflect.Value.Pointer() on fn itself -- which is shared by
+    // every closure created from the same literal, e.g. one per iteration
+    // of a registration loop -- each topicListener is a fresh allocation
+    // with its own unique identity, so UnsubscribeTopic can tell distinct
+    // subscriptions apart even when their functions are indistinguishable.
+    topicListener struct {
+        node *TopicSubscription
+        fn   interface{}
+    }
+
     // EventBus
     EventBus struct {
-        dispatcher   chan interface{}
-        quit         chan bool
-        subscription Subscription
-        waitGroup    sync.WaitGroup
+        dispatcher       chan interface{}
+        quit             chan bool
+        subscription     Subscription
+        waitGroup        sync.WaitGroup
+        topics           *TopicSubscription
+        topicsMutex      sync.Mutex
+        decorated        map[reflect.Type][]*decoratedListener
+        decoratedMutex   sync.Mutex
+        repliers         map[reflect.Type]interface{}
+        repliersMutex    sync.Mutex
+        jobs             chan job
+        serialQueues     map[reflect.Type]chan job
+        serialMutex      sync.Mutex
+        hasSyncListeners atomic.Bool
+    }
+
+    // publishEnvelope wraps a Publish event as it travels through the
+    // dispatcher channel whenever the bus has sync listeners, carrying the
+    // channel Publish blocks on until the sync pass for this event completes.
+    publishEnvelope struct {
+        event interface{}
+        done  chan struct{}
+    }
+
+    // job is a single listener invocation routed through a worker pool
+    // instead of its own goroutine.
+    job struct {
+        listener interface{}
+        event    interface{}
+    }
+
+    // replyEnvelope wraps a PublishWithReply event as it travels through
+    // the dispatcher channel, carrying the channel its result must be
+    // delivered back on.
+    replyEnvelope struct {
+        event interface{}
+        reply chan interface{}
+    }
+
+    // decoratedListener is a listener registered through one of the
+    // non-default Subscribe* methods (SubscribeOnce, SubscribeWhere,
+    // SubscribeSync), carrying the dispatch behaviour alertListeners must
+    // apply to it directly alongside the listener itself. Each instance is
+    // a distinct heap allocation, so its pointer identity (unlike a raw
+    // func's reflect.Value.Pointer(), which is shared by every closure
+    // created from the same literal) reliably identifies one subscription.
+    decoratedListener struct {
+        listener  interface{}
+        once      bool
+        sync      bool
+        predicate func(event interface{}) (bool)
     }
 
     // ListenerSet is a struct that uses an interface{} slice